@@ -20,14 +20,17 @@ import (
 
 func ResourceAppCookieStickinessPolicy() *schema.Resource {
 	return &schema.Resource{
-		// There is no concept of "updating" an App Stickiness policy in
-		// the AWS API.
+		// There is no concept of "updating" the App Stickiness policy
+		// itself in the AWS API, but the listener it's attached to can
+		// change (or drift out-of-band), so Update re-attaches it.
 		CreateWithoutTimeout: resourceAppCookieStickinessPolicyCreate,
 		ReadWithoutTimeout:   resourceAppCookieStickinessPolicyRead,
+		UpdateWithoutTimeout: resourceAppCookieStickinessPolicyUpdate,
 		DeleteWithoutTimeout: resourceAppCookieStickinessPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceAppCookieStickinessPolicyCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -53,7 +56,6 @@ func ResourceAppCookieStickinessPolicy() *schema.Resource {
 			"lb_port": {
 				Type:     schema.TypeInt,
 				Required: true,
-				ForceNew: true,
 			},
 
 			"cookie_name": {
@@ -61,10 +63,31 @@ func ResourceAppCookieStickinessPolicy() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+
+			"policy_attached": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
 
+// resourceAppCookieStickinessPolicyCustomizeDiff forces a diff when Read's
+// last refresh found the policy detached from its listener, so that a
+// detach that happened out-of-band is repaired by the next apply instead of
+// by Read itself (Read must only observe state, not mutate infrastructure).
+func resourceAppCookieStickinessPolicyCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if attached, _ := diff.Get("policy_attached").(bool); !attached {
+		return diff.SetNewComputed("policy_attached")
+	}
+
+	return nil
+}
+
 func resourceAppCookieStickinessPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ELBConn()
@@ -80,23 +103,80 @@ func resourceAppCookieStickinessPolicyCreate(ctx context.Context, d *schema.Reso
 		return sdkdiag.AppendErrorf(diags, "creating AppCookieStickinessPolicy: %s", err)
 	}
 
-	setLoadBalancerOpts := &elb.SetLoadBalancerPoliciesOfListenerInput{
-		LoadBalancerName: aws.String(d.Get("load_balancer").(string)),
-		LoadBalancerPort: aws.Int64(int64(d.Get("lb_port").(int))),
-		PolicyNames:      []*string{aws.String(d.Get("name").(string))},
-	}
+	lbName := d.Get("load_balancer").(string)
+	lbPort := int64(d.Get("lb_port").(int))
+	policyName := d.Get("name").(string)
 
-	if _, err := conn.SetLoadBalancerPoliciesOfListenerWithContext(ctx, setLoadBalancerOpts); err != nil {
+	if err := attachAppCookieStickinessPolicy(ctx, conn, lbName, lbPort, policyName); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting AppCookieStickinessPolicy: %s", err)
 	}
 
-	d.SetId(fmt.Sprintf("%s:%d:%s",
-		*acspOpts.LoadBalancerName,
-		*setLoadBalancerOpts.LoadBalancerPort,
-		*acspOpts.PolicyName))
+	d.SetId(fmt.Sprintf("%s:%d:%s", lbName, lbPort, policyName))
+	d.Set("policy_attached", true)
+
 	return diags
 }
 
+func resourceAppCookieStickinessPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBConn()
+
+	// Runs on an lb_port change, and also on a no-op config change driven
+	// purely by CustomizeDiff detecting that Read found the policy detached
+	// from its listener. Either way the fix is the same: (re-)attach it.
+	lbName := d.Get("load_balancer").(string)
+	lbPort := int64(d.Get("lb_port").(int))
+	policyName := d.Get("name").(string)
+
+	if d.HasChange("lb_port") {
+		oldPortRaw, _ := d.GetChange("lb_port")
+		oldPort := int64(oldPortRaw.(int))
+
+		// The policy can only be bound to one listener at a time, so moving
+		// it to the new lb_port leaves it dangling on the old one unless we
+		// detach it first (mirrors Delete's use of an empty PolicyNames).
+		if err := detachAppCookieStickinessPolicy(ctx, conn, lbName, oldPort); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating AppCookieStickinessPolicy (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := attachAppCookieStickinessPolicy(ctx, conn, lbName, lbPort, policyName); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating AppCookieStickinessPolicy (%s): %s", d.Id(), err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d:%s", lbName, lbPort, policyName))
+
+	return append(diags, resourceAppCookieStickinessPolicyRead(ctx, d, meta)...)
+}
+
+// attachAppCookieStickinessPolicy (re-)binds an existing App Cookie
+// Stickiness policy to a listener. It's idempotent, so it's safe to call
+// both on create and to self-heal a policy that was detached out-of-band.
+func attachAppCookieStickinessPolicy(ctx context.Context, conn *elb.ELB, lbName string, lbPort int64, policyName string) error {
+	input := &elb.SetLoadBalancerPoliciesOfListenerInput{
+		LoadBalancerName: aws.String(lbName),
+		LoadBalancerPort: aws.Int64(lbPort),
+		PolicyNames:      []*string{aws.String(policyName)},
+	}
+
+	_, err := conn.SetLoadBalancerPoliciesOfListenerWithContext(ctx, input)
+	return err
+}
+
+// detachAppCookieStickinessPolicy unbinds whatever policy is set on a
+// listener by setting an empty PolicyNames list, the same trick Delete uses.
+// Used by Update to vacate the old lb_port before attaching at the new one.
+func detachAppCookieStickinessPolicy(ctx context.Context, conn *elb.ELB, lbName string, lbPort int64) error {
+	input := &elb.SetLoadBalancerPoliciesOfListenerInput{
+		LoadBalancerName: aws.String(lbName),
+		LoadBalancerPort: aws.Int64(lbPort),
+		PolicyNames:      []*string{},
+	}
+
+	_, err := conn.SetLoadBalancerPoliciesOfListenerWithContext(ctx, input)
+	return err
+}
+
 func resourceAppCookieStickinessPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ELBConn()
@@ -128,16 +208,19 @@ func resourceAppCookieStickinessPolicyRead(ctx context.Context, d *schema.Resour
 		return sdkdiag.AppendErrorf(diags, "Unable to find policy %#v", getResp.PolicyDescriptions)
 	}
 
-	// we know the policy exists now, but we have to check if it's assigned to a listener
+	// We know the policy exists now, but we have to check if it's assigned
+	// to a listener. The policy object can still exist after being detached
+	// out-of-band (e.g. manually, or by another policy resource taking over
+	// the listener); record that here and let CustomizeDiff/Update repair
+	// it on the next apply instead of mutating infrastructure from Read.
 	assigned, err := resourceSticknessPolicyAssigned(ctx, conn, policyName, lbName, lbPort)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading ELB Classic App Cookie Stickiness Policy (%s): %s", d.Id(), err)
 	}
-	if !d.IsNewResource() && !assigned {
-		log.Printf("[WARN] ELB Classic LB (%s) App Cookie Policy (%s) exists, but isn't assigned to a listener", lbName, policyName)
-		d.SetId("")
-		return diags
+	if !assigned {
+		log.Printf("[INFO] ELB Classic LB (%s) App Cookie Policy (%s) isn't assigned to listener %s", lbName, policyName, lbPort)
 	}
+	d.Set("policy_attached", assigned)
 
 	// We can get away with this because there's only one attribute, the
 	// cookie expiration, in these descriptions.
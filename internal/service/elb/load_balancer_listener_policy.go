@@ -0,0 +1,246 @@
+package elb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+const (
+	loadBalancerListenerPolicyKindListener = "listener"
+	loadBalancerListenerPolicyKindBackend  = "backend"
+)
+
+func ResourceLoadBalancerListenerPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLoadBalancerListenerPolicyCreate,
+		ReadWithoutTimeout:   resourceLoadBalancerListenerPolicyRead,
+		UpdateWithoutTimeout: resourceLoadBalancerListenerPolicyUpdate,
+		DeleteWithoutTimeout: resourceLoadBalancerListenerPolicyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"load_balancer_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"load_balancer_port": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"instance_port"},
+			},
+
+			"instance_port": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"load_balancer_port"},
+			},
+
+			"policy_names": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerListenerPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBConn()
+
+	lbName := d.Get("load_balancer_name").(string)
+	policyNames := flex.ExpandStringList(d.Get("policy_names").([]interface{}))
+
+	kind, port, err := loadBalancerListenerPolicyKindAndPort(d)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating ELB Classic Load Balancer Listener Policy: %s", err)
+	}
+
+	if err := setLoadBalancerListenerPolicy(ctx, conn, kind, lbName, port, policyNames); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating ELB Classic Load Balancer Listener Policy: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%d", lbName, kind, port))
+
+	return append(diags, resourceLoadBalancerListenerPolicyRead(ctx, d, meta)...)
+}
+
+func resourceLoadBalancerListenerPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBConn()
+
+	lbName, kind, port, err := LoadBalancerListenerPolicyParseID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ELB Classic Load Balancer Listener Policy (%s): %s", d.Id(), err)
+	}
+
+	input := &elb.DescribeLoadBalancersInput{
+		LoadBalancerNames: []*string{aws.String(lbName)},
+	}
+
+	output, err := conn.DescribeLoadBalancersWithContext(ctx, input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, elb.ErrCodeAccessPointNotFoundException) {
+		log.Printf("[WARN] ELB Classic LB (%s) not found, removing Listener Policy (%s) from state", lbName, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ELB Classic Load Balancer Listener Policy (%s): %s", d.Id(), err)
+	}
+
+	if len(output.LoadBalancerDescriptions) != 1 {
+		log.Printf("[WARN] ELB Classic LB (%s) not found, removing Listener Policy (%s) from state", lbName, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	lb := output.LoadBalancerDescriptions[0]
+	policyNames, found := findLoadBalancerListenerPolicyNames(lb, kind, port)
+
+	if !d.IsNewResource() && !found {
+		log.Printf("[WARN] ELB Classic LB (%s) Listener Policy (%s) not found, removing from state", lbName, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("load_balancer_name", lbName)
+
+	switch kind {
+	case loadBalancerListenerPolicyKindBackend:
+		d.Set("instance_port", port)
+	default:
+		d.Set("load_balancer_port", port)
+	}
+
+	d.Set("policy_names", flex.FlattenStringList(policyNames))
+
+	return diags
+}
+
+func resourceLoadBalancerListenerPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBConn()
+
+	lbName, kind, port, err := LoadBalancerListenerPolicyParseID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating ELB Classic Load Balancer Listener Policy (%s): %s", d.Id(), err)
+	}
+
+	policyNames := flex.ExpandStringList(d.Get("policy_names").([]interface{}))
+
+	if err := setLoadBalancerListenerPolicy(ctx, conn, kind, lbName, port, policyNames); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating ELB Classic Load Balancer Listener Policy (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceLoadBalancerListenerPolicyRead(ctx, d, meta)...)
+}
+
+func resourceLoadBalancerListenerPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBConn()
+
+	lbName, kind, port, err := LoadBalancerListenerPolicyParseID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ELB Classic Load Balancer Listener Policy (%s): %s", d.Id(), err)
+	}
+
+	// Setting an empty list of policy names detaches whatever policies are
+	// currently bound to the listener/backend server without touching the
+	// policy objects themselves.
+	if err := setLoadBalancerListenerPolicy(ctx, conn, kind, lbName, port, []*string{}); err != nil {
+		if tfawserr.ErrCodeEquals(err, elb.ErrCodeAccessPointNotFoundException) {
+			return diags
+		}
+		return sdkdiag.AppendErrorf(diags, "deleting ELB Classic Load Balancer Listener Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func loadBalancerListenerPolicyKindAndPort(d *schema.ResourceData) (string, int64, error) {
+	if v, ok := d.GetOk("instance_port"); ok {
+		return loadBalancerListenerPolicyKindBackend, int64(v.(int)), nil
+	}
+
+	if v, ok := d.GetOk("load_balancer_port"); ok {
+		return loadBalancerListenerPolicyKindListener, int64(v.(int)), nil
+	}
+
+	return "", 0, fmt.Errorf("one of load_balancer_port or instance_port must be set")
+}
+
+func setLoadBalancerListenerPolicy(ctx context.Context, conn *elb.ELB, kind, lbName string, port int64, policyNames []*string) error {
+	switch kind {
+	case loadBalancerListenerPolicyKindBackend:
+		input := &elb.SetLoadBalancerPoliciesForBackendServerInput{
+			LoadBalancerName: aws.String(lbName),
+			InstancePort:     aws.Int64(port),
+			PolicyNames:      policyNames,
+		}
+		_, err := conn.SetLoadBalancerPoliciesForBackendServerWithContext(ctx, input)
+		return err
+	default:
+		input := &elb.SetLoadBalancerPoliciesOfListenerInput{
+			LoadBalancerName: aws.String(lbName),
+			LoadBalancerPort: aws.Int64(port),
+			PolicyNames:      policyNames,
+		}
+		_, err := conn.SetLoadBalancerPoliciesOfListenerWithContext(ctx, input)
+		return err
+	}
+}
+
+func findLoadBalancerListenerPolicyNames(lb *elb.LoadBalancerDescription, kind string, port int64) ([]*string, bool) {
+	if kind == loadBalancerListenerPolicyKindBackend {
+		for _, backend := range lb.BackendServerDescriptions {
+			if backend == nil || aws.Int64Value(backend.InstancePort) != port {
+				continue
+			}
+			return backend.PolicyNames, true
+		}
+		return nil, false
+	}
+
+	for _, listener := range lb.ListenerDescriptions {
+		if listener == nil || listener.Listener == nil || aws.Int64Value(listener.Listener.LoadBalancerPort) != port {
+			continue
+		}
+		return listener.PolicyNames, true
+	}
+
+	return nil, false
+}
+
+// LoadBalancerListenerPolicyParseID takes an ID and parses it into its
+// constituent parts: the LB name, the kind of binding (listener or backend),
+// and the port.
+func LoadBalancerListenerPolicyParseID(id string) (string, string, int64, error) {
+	parts := strings.SplitN(id, ":", 3)
+	if len(parts) != 3 {
+		return "", "", 0, fmt.Errorf("unexpected format of ID (%s), expected LBNAME:KIND:PORT", id)
+	}
+
+	port, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("unexpected format of ID (%s): %w", id, err)
+	}
+
+	return parts[0], parts[1], port, nil
+}
@@ -0,0 +1,278 @@
+package elb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func ResourceLoadBalancerPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLoadBalancerPolicyCreate,
+		ReadWithoutTimeout:   resourceLoadBalancerPolicyRead,
+		DeleteWithoutTimeout: resourceLoadBalancerPolicyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"load_balancer_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"policy_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"policy_type_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"policy_attribute": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBConn()
+
+	lbName := d.Get("load_balancer_name").(string)
+	policyName := d.Get("policy_name").(string)
+	policyTypeName := d.Get("policy_type_name").(string)
+	policyAttributes := d.Get("policy_attribute").(*schema.Set).List()
+
+	if err := validateLoadBalancerPolicyTypeName(ctx, conn, policyTypeName, policyAttributes); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating ELB Classic Load Balancer Policy (%s): %s", policyName, err)
+	}
+
+	input := &elb.CreateLoadBalancerPolicyInput{
+		LoadBalancerName: aws.String(lbName),
+		PolicyName:       aws.String(policyName),
+		PolicyTypeName:   aws.String(policyTypeName),
+		PolicyAttributes: expandPolicyAttributes(d.Get("policy_attribute").(*schema.Set).List()),
+	}
+
+	_, err := conn.CreateLoadBalancerPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating ELB Classic Load Balancer Policy (%s): %s", policyName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", lbName, policyName))
+
+	return append(diags, resourceLoadBalancerPolicyRead(ctx, d, meta)...)
+}
+
+func resourceLoadBalancerPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBConn()
+
+	lbName, policyName, err := LoadBalancerPolicyParseID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ELB Classic Load Balancer Policy (%s): %s", d.Id(), err)
+	}
+
+	input := &elb.DescribeLoadBalancerPoliciesInput{
+		LoadBalancerName: aws.String(lbName),
+		PolicyNames:      []*string{aws.String(policyName)},
+	}
+
+	output, err := conn.DescribeLoadBalancerPoliciesWithContext(ctx, input)
+
+	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, elb.ErrCodePolicyNotFoundException) || tfawserr.ErrCodeEquals(err, elb.ErrCodeAccessPointNotFoundException)) {
+		log.Printf("[WARN] ELB Classic Load Balancer Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ELB Classic Load Balancer Policy (%s): %s", d.Id(), err)
+	}
+
+	if len(output.PolicyDescriptions) != 1 {
+		log.Printf("[WARN] ELB Classic Load Balancer Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	policyDesc := output.PolicyDescriptions[0]
+	d.Set("load_balancer_name", lbName)
+	d.Set("policy_name", policyName)
+	d.Set("policy_type_name", policyDesc.PolicyTypeName)
+
+	// AWS returns every attribute the policy type supports, including ones
+	// left at their default value that were never set in configuration
+	// (e.g. SSLNegotiation expands to dozens of cipher/protocol attributes).
+	// Reconcile against what's configured so Read doesn't produce a
+	// perpetual diff for attributes the user never set.
+	configured := d.Get("policy_attribute").(*schema.Set).List()
+	if err := d.Set("policy_attribute", reconcilePolicyAttributes(configured, policyDesc.PolicyAttributeDescriptions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting policy_attribute: %s", err)
+	}
+
+	return diags
+}
+
+func resourceLoadBalancerPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBConn()
+
+	lbName, policyName, err := LoadBalancerPolicyParseID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ELB Classic Load Balancer Policy (%s): %s", d.Id(), err)
+	}
+
+	input := &elb.DeleteLoadBalancerPolicyInput{
+		LoadBalancerName: aws.String(lbName),
+		PolicyName:       aws.String(policyName),
+	}
+
+	_, err = conn.DeleteLoadBalancerPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, elb.ErrCodeAccessPointNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ELB Classic Load Balancer Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// validateLoadBalancerPolicyTypeName confirms that the given policy type is one ELB
+// Classic actually supports in this Region, and that every configured
+// policy_attribute name is one the policy type actually accepts, before
+// attempting to create it, so users get a plan-time-adjacent error instead
+// of an opaque API failure.
+func validateLoadBalancerPolicyTypeName(ctx context.Context, conn *elb.ELB, policyTypeName string, policyAttributes []interface{}) error {
+	input := &elb.DescribeLoadBalancerPolicyTypesInput{
+		PolicyTypeNames: []*string{aws.String(policyTypeName)},
+	}
+
+	output, err := conn.DescribeLoadBalancerPolicyTypesWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("invalid policy_type_name (%s): %w", policyTypeName, err)
+	}
+
+	if len(output.PolicyTypeDescriptions) != 1 {
+		return fmt.Errorf("invalid policy_type_name (%s): no matching policy type returned", policyTypeName)
+	}
+
+	validAttributeNames := make(map[string]struct{})
+	for _, attrType := range output.PolicyTypeDescriptions[0].PolicyAttributeTypeDescriptions {
+		if attrType == nil {
+			continue
+		}
+		validAttributeNames[aws.StringValue(attrType.AttributeName)] = struct{}{}
+	}
+
+	for _, tfMapRaw := range policyAttributes {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+		if _, ok := validAttributeNames[name]; !ok {
+			return fmt.Errorf("invalid policy_attribute name (%s) for policy_type_name (%s)", name, policyTypeName)
+		}
+	}
+
+	return nil
+}
+
+func expandPolicyAttributes(tfList []interface{}) []*elb.PolicyAttribute {
+	var apiObjects []*elb.PolicyAttribute
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &elb.PolicyAttribute{
+			AttributeName:  aws.String(tfMap["name"].(string)),
+			AttributeValue: aws.String(tfMap["value"].(string)),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// reconcilePolicyAttributes filters the full set of attributes AWS returns
+// for a policy down to just the ones present in configuration, substituting
+// in AWS's current value for each. Policy types like SSLNegotiation report
+// dozens of attributes that default without ever being set in config; if
+// those were flattened as-is, Read would produce a diff on every plan.
+func reconcilePolicyAttributes(configured []interface{}, apiObjects []*elb.PolicyAttributeDescription) []interface{} {
+	configuredNames := make(map[string]struct{}, len(configured))
+	for _, tfMapRaw := range configured {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			configuredNames[tfMap["name"].(string)] = struct{}{}
+		}
+	}
+
+	tfList := make([]interface{}, 0, len(configuredNames))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		name := aws.StringValue(apiObject.AttributeName)
+		if _, ok := configuredNames[name]; !ok {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":  name,
+			"value": aws.StringValue(apiObject.AttributeValue),
+		})
+	}
+
+	return tfList
+}
+
+// LoadBalancerPolicyParseID takes an ID and parses it into its constituent
+// parts: the LB name and the policy name.
+func LoadBalancerPolicyParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected LBNAME:POLICYNAME", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,37 @@
+package location
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+func FindPlaceIndexByName(ctx context.Context, conn *locationservice.LocationService, name string) (*locationservice.DescribePlaceIndexOutput, error) {
+	input := &locationservice.DescribePlaceIndexInput{
+		IndexName: &name,
+	}
+
+	output, err := conn.DescribePlaceIndexWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &retry.NotFoundError{
+			Message:     "empty response",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
@@ -2,21 +2,50 @@ package location
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/locationservice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// placeIndexStorageCompatibleDataSource is the only data_source provider that
+// currently supports IntendedUse=Storage; see
+// https://docs.aws.amazon.com/location/latest/developerguide/pricing-plan-restrictions.html.
+const placeIndexStorageCompatibleDataSource = "Here"
+
+// searchDefaultsTagKey is a provider-managed tag used to round-trip
+// search_defaults through the index itself: it's not an attribute the
+// Location Service API knows about, so aws_location_places can only pick it
+// up by reading it back off the index it's searching, the same way it reads
+// everything else about the index.
+const searchDefaultsTagKey = "terraform-search-defaults"
+
+// placeIndexSearchDefaults mirrors the search_defaults block and is the
+// payload JSON-encoded into searchDefaultsTagKey.
+type placeIndexSearchDefaults struct {
+	BiasPosition    []float64 `json:"bias_position,omitempty"`
+	FilterCountries []string  `json:"filter_countries,omitempty"`
+	Language        string    `json:"language,omitempty"`
+}
+
+func (d placeIndexSearchDefaults) IsZero() bool {
+	return len(d.BiasPosition) == 0 && len(d.FilterCountries) == 0 && d.Language == ""
+}
+
 func ResourcePlaceIndex() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePlaceIndexCreate,
@@ -67,6 +96,37 @@ func ResourcePlaceIndex() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
+			// search_defaults has no equivalent in the Location Service API:
+			// it's stored in this resource's state and, so that
+			// aws_location_places can discover it from the index alone,
+			// mirrored into a reserved tag (searchDefaultsTagKey) on the
+			// index. That tag is managed internally, excluded from
+			// tags/tags_all, and counts against the index's 50-tag limit;
+			// configuring tags/default_tags with the same key is rejected.
+			"search_defaults": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bias_position": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 2,
+							Elem:     &schema.Schema{Type: schema.TypeFloat},
+						},
+						"filter_countries": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"language": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"update_time": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -74,8 +134,54 @@ func ResourcePlaceIndex() *schema.Resource {
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourcePlaceIndexCustomizeDiff,
+		),
+	}
+}
+
+// resourcePlaceIndexCustomizeDiff enforces the AWS constraint that
+// IntendedUse=Storage is only supported by certain data_source providers,
+// surfacing the error at plan time instead of failing the apply.
+func resourcePlaceIndexCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := validateSearchDefaultsTagNotUserSet(diff.Get("tags").(map[string]interface{})); err != nil {
+		return err
+	}
+
+	// tags_all already has default_tags merged in by verify.SetTagsDiff.
+	if err := validateSearchDefaultsTagNotUserSet(diff.Get("tags_all").(map[string]interface{})); err != nil {
+		return err
+	}
+
+	v, ok := diff.GetOk("data_source_configuration")
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+	if tfMap["intended_use"].(string) != locationservice.IntendedUseStorage {
+		return nil
+	}
+
+	dataSource := diff.Get("data_source").(string)
+	if !strings.EqualFold(dataSource, placeIndexStorageCompatibleDataSource) {
+		return fmt.Errorf("data_source_configuration.intended_use %q is only supported when data_source is %q, got %q", locationservice.IntendedUseStorage, placeIndexStorageCompatibleDataSource, dataSource)
+	}
+
+	return nil
+}
+
+// validateSearchDefaultsTagNotUserSet rejects configuring tags/default_tags
+// with searchDefaultsTagKey, since that key is reserved for round-tripping
+// search_defaults (see the doc comment on searchDefaultsTagKey) and a
+// user-supplied value for it would be silently overwritten.
+func validateSearchDefaultsTagNotUserSet(tags map[string]interface{}) error {
+	if _, ok := tags[searchDefaultsTagKey]; ok {
+		return fmt.Errorf("tags: key %q is reserved for this resource's search_defaults and cannot be set directly", searchDefaultsTagKey)
 	}
+
+	return nil
 }
 
 func resourcePlaceIndexCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -84,6 +190,10 @@ func resourcePlaceIndexCreate(ctx context.Context, d *schema.ResourceData, meta
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
+	if err := validateSearchDefaultsTagNotUserSet(d.Get("tags").(map[string]interface{})); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating place index: %s", err)
+	}
+
 	input := &locationservice.CreatePlaceIndexInput{}
 
 	if v, ok := d.GetOk("data_source"); ok {
@@ -106,6 +216,13 @@ func resourcePlaceIndexCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
 
+	if encoded := encodeSearchDefaultsTag(d.Get("search_defaults").([]interface{})); encoded != "" {
+		if input.Tags == nil {
+			input.Tags = make(map[string]*string)
+		}
+		input.Tags[searchDefaultsTagKey] = aws.String(encoded)
+	}
+
 	output, err := conn.CreatePlaceIndexWithContext(ctx, input)
 
 	if err != nil {
@@ -127,13 +244,9 @@ func resourcePlaceIndexRead(ctx context.Context, d *schema.ResourceData, meta in
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	input := &locationservice.DescribePlaceIndexInput{
-		IndexName: aws.String(d.Id()),
-	}
-
-	output, err := conn.DescribePlaceIndexWithContext(ctx, input)
+	output, err := FindPlaceIndexByName(ctx, conn, d.Id())
 
-	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Location Service Place Index (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return diags
@@ -143,10 +256,6 @@ func resourcePlaceIndexRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "getting Location Service Place Index (%s): %s", d.Id(), err)
 	}
 
-	if output == nil {
-		return sdkdiag.AppendErrorf(diags, "getting Location Service Place Index (%s): empty response", d.Id())
-	}
-
 	d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
 	d.Set("data_source", output.DataSource)
 
@@ -160,7 +269,25 @@ func resourcePlaceIndexRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set("index_arn", output.IndexArn)
 	d.Set("index_name", output.IndexName)
 
-	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	searchDefaults := decodeSearchDefaultsTag(output.Tags)
+	if searchDefaults.IsZero() {
+		d.Set("search_defaults", nil)
+	} else {
+		d.Set("search_defaults", []interface{}{flattenPlaceIndexSearchDefaults(searchDefaults)})
+	}
+
+	// searchDefaultsTagKey is provider-managed state, not a user- or
+	// default_tags-supplied tag, so it's excluded before it ever reaches
+	// the tags/tags_all attributes.
+	apiTags := make(map[string]*string, len(output.Tags))
+	for k, v := range output.Tags {
+		if k == searchDefaultsTagKey {
+			continue
+		}
+		apiTags[k] = v
+	}
+
+	tags := KeyValueTags(apiTags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
@@ -179,6 +306,10 @@ func resourcePlaceIndexUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LocationConn()
 
+	if err := validateSearchDefaultsTagNotUserSet(d.Get("tags").(map[string]interface{})); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Location Service Place Index (%s): %s", d.Id(), err)
+	}
+
 	if d.HasChanges("data_source_configuration", "description") {
 		input := &locationservice.UpdatePlaceIndexInput{
 			IndexName: aws.String(d.Id()),
@@ -209,6 +340,26 @@ func resourcePlaceIndexUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.HasChange("search_defaults") {
+		o, n := d.GetChange("search_defaults")
+		oldEncoded := encodeSearchDefaultsTag(o.([]interface{}))
+		newEncoded := encodeSearchDefaultsTag(n.([]interface{}))
+
+		oldTags := map[string]interface{}{}
+		if oldEncoded != "" {
+			oldTags[searchDefaultsTagKey] = oldEncoded
+		}
+
+		newTags := map[string]interface{}{}
+		if newEncoded != "" {
+			newTags[searchDefaultsTagKey] = newEncoded
+		}
+
+		if err := UpdateTags(ctx, conn, d.Get("index_arn").(string), oldTags, newTags); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating search_defaults for Location Service Place Index (%s): %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourcePlaceIndexRead(ctx, d, meta)...)
 }
 
@@ -247,6 +398,82 @@ func expandDataSourceConfiguration(tfMap map[string]interface{}) *locationservic
 	return apiObject
 }
 
+func expandPlaceIndexSearchDefaults(tfList []interface{}) placeIndexSearchDefaults {
+	var apiObject placeIndexSearchDefaults
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	for _, v := range tfMap["bias_position"].([]interface{}) {
+		if f, ok := v.(float64); ok {
+			apiObject.BiasPosition = append(apiObject.BiasPosition, f)
+		}
+	}
+
+	for _, v := range tfMap["filter_countries"].([]interface{}) {
+		if s, ok := v.(string); ok {
+			apiObject.FilterCountries = append(apiObject.FilterCountries, s)
+		}
+	}
+
+	apiObject.Language = tfMap["language"].(string)
+
+	return apiObject
+}
+
+func flattenPlaceIndexSearchDefaults(apiObject placeIndexSearchDefaults) map[string]interface{} {
+	biasPosition := make([]interface{}, len(apiObject.BiasPosition))
+	for i, v := range apiObject.BiasPosition {
+		biasPosition[i] = v
+	}
+
+	filterCountries := make([]interface{}, len(apiObject.FilterCountries))
+	for i, v := range apiObject.FilterCountries {
+		filterCountries[i] = v
+	}
+
+	return map[string]interface{}{
+		"bias_position":    biasPosition,
+		"filter_countries": filterCountries,
+		"language":         apiObject.Language,
+	}
+}
+
+// encodeSearchDefaultsTag JSON-encodes the configured search_defaults block
+// for storage in searchDefaultsTagKey, returning "" when nothing is set.
+func encodeSearchDefaultsTag(tfList []interface{}) string {
+	apiObject := expandPlaceIndexSearchDefaults(tfList)
+	if apiObject.IsZero() {
+		return ""
+	}
+
+	b, err := json.Marshal(apiObject)
+	if err != nil {
+		return ""
+	}
+
+	return string(b)
+}
+
+// decodeSearchDefaultsTag is the inverse of encodeSearchDefaultsTag, reading
+// back the search_defaults an index was configured with from its tags.
+func decodeSearchDefaultsTag(tags map[string]*string) placeIndexSearchDefaults {
+	var apiObject placeIndexSearchDefaults
+
+	v, ok := tags[searchDefaultsTagKey]
+	if !ok || v == nil {
+		return apiObject
+	}
+
+	// Best-effort: a malformed or foreign tag value just yields no defaults.
+	_ = json.Unmarshal([]byte(aws.StringValue(v)), &apiObject)
+
+	return apiObject
+}
+
 func flattenDataSourceConfiguration(apiObject *locationservice.DataSourceConfiguration) map[string]interface{} {
 	if apiObject == nil {
 		return nil
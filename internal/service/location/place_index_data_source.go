@@ -0,0 +1,97 @@
+package location
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourcePlaceIndex() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePlaceIndexRead,
+
+		Schema: map[string]*schema.Schema{
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_source": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_source_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"intended_use": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePlaceIndexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LocationConn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	indexName := d.Get("index_name").(string)
+
+	output, err := FindPlaceIndexByName(ctx, conn, indexName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Location Service Place Index (%s): %s", indexName, err)
+	}
+
+	d.SetId(aws.StringValue(output.IndexName))
+
+	d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
+	d.Set("data_source", output.DataSource)
+
+	if output.DataSourceConfiguration != nil {
+		d.Set("data_source_configuration", []interface{}{flattenDataSourceConfiguration(output.DataSourceConfiguration)})
+	} else {
+		d.Set("data_source_configuration", nil)
+	}
+
+	d.Set("description", output.Description)
+	d.Set("index_arn", output.IndexArn)
+	d.Set("index_name", output.IndexName)
+	d.Set("update_time", aws.TimeValue(output.UpdateTime).Format(time.RFC3339))
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
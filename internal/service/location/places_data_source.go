@@ -0,0 +1,301 @@
+package location
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourcePlaces() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePlacesRead,
+
+		Schema: map[string]*schema.Schema{
+			"bias_position": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      2,
+				Elem:          &schema.Schema{Type: schema.TypeFloat},
+				ConflictsWith: []string{"filter_bbox"},
+			},
+			"filter_bbox": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      4,
+				Elem:          &schema.Schema{Type: schema.TypeFloat},
+				ConflictsWith: []string{"bias_position"},
+			},
+			"filter_countries": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"index_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"language": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+			"position": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     2,
+				Elem:         &schema.Schema{Type: schema.TypeFloat},
+				ExactlyOneOf: []string{"text", "position"},
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"country": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"geometry": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"point": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeFloat},
+									},
+								},
+							},
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"municipality": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"postal_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time_zone": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"offset": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"text": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"text", "position"},
+			},
+		},
+	}
+}
+
+func dataSourcePlacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LocationConn()
+
+	indexName := d.Get("index_name").(string)
+
+	indexOutput, err := FindPlaceIndexByName(ctx, conn, indexName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Location Service Place Index (%s): %s", indexName, err)
+	}
+
+	searchDefaults := decodeSearchDefaultsTag(indexOutput.Tags)
+
+	text, hasText := d.GetOk("text")
+	position, hasPosition := d.GetOk("position")
+
+	var results []*locationservice.SearchForPositionResult
+
+	switch {
+	case hasText:
+		input := &locationservice.SearchPlaceIndexForTextInput{
+			IndexName: aws.String(indexName),
+			Text:      aws.String(text.(string)),
+		}
+
+		_, hasFilterBBox := d.GetOk("filter_bbox")
+
+		if v, ok := d.GetOk("bias_position"); ok {
+			input.BiasPosition = expandFloat64List(v.([]interface{}))
+		} else if len(searchDefaults.BiasPosition) > 0 && !hasFilterBBox {
+			input.BiasPosition = expandFloat64Slice(searchDefaults.BiasPosition)
+		}
+
+		if v, ok := d.GetOk("filter_bbox"); ok {
+			input.FilterBBox = expandFloat64List(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("filter_countries"); ok {
+			input.FilterCountries = flex.ExpandStringList(v.([]interface{}))
+		} else if len(searchDefaults.FilterCountries) > 0 {
+			input.FilterCountries = aws.StringSlice(searchDefaults.FilterCountries)
+		}
+
+		if v, ok := d.GetOk("language"); ok {
+			input.Language = aws.String(v.(string))
+		} else if searchDefaults.Language != "" {
+			input.Language = aws.String(searchDefaults.Language)
+		}
+
+		if v, ok := d.GetOk("max_results"); ok {
+			input.MaxResults = aws.Int64(int64(v.(int)))
+		}
+
+		output, err := conn.SearchPlaceIndexForTextWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "searching Location Service Place Index (%s) for text: %s", indexName, err)
+		}
+
+		for _, result := range output.Results {
+			results = append(results, &locationservice.SearchForPositionResult{
+				Distance: result.Distance,
+				Place:    result.Place,
+			})
+		}
+	case hasPosition:
+		input := &locationservice.SearchPlaceIndexForPositionInput{
+			IndexName: aws.String(indexName),
+			Position:  expandFloat64List(position.([]interface{})),
+		}
+
+		if v, ok := d.GetOk("language"); ok {
+			input.Language = aws.String(v.(string))
+		} else if searchDefaults.Language != "" {
+			input.Language = aws.String(searchDefaults.Language)
+		}
+
+		if v, ok := d.GetOk("max_results"); ok {
+			input.MaxResults = aws.Int64(int64(v.(int)))
+		}
+
+		output, err := conn.SearchPlaceIndexForPositionWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "searching Location Service Place Index (%s) for position: %s", indexName, err)
+		}
+
+		results = output.Results
+	default:
+		return sdkdiag.AppendErrorf(diags, "one of %q or %q must be set", "text", "position")
+	}
+
+	d.SetId(indexName)
+
+	if err := d.Set("results", flattenSearchForPositionResults(results)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting results: %s", err)
+	}
+
+	return diags
+}
+
+func expandFloat64Slice(values []float64) []*float64 {
+	apiObjects := make([]*float64, len(values))
+
+	for i, v := range values {
+		apiObjects[i] = aws.Float64(v)
+	}
+
+	return apiObjects
+}
+
+func expandFloat64List(tfList []interface{}) []*float64 {
+	apiObjects := make([]*float64, 0, len(tfList))
+
+	for _, tfValue := range tfList {
+		v, ok := tfValue.(float64)
+		if !ok {
+			continue
+		}
+		apiObjects = append(apiObjects, aws.Float64(v))
+	}
+
+	return apiObjects
+}
+
+func flattenFloat64List(apiObjects []*float64) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, aws.Float64Value(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenSearchForPositionResults(apiObjects []*locationservice.SearchForPositionResult) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.Place == nil {
+			continue
+		}
+
+		place := apiObject.Place
+		tfMap := map[string]interface{}{
+			"country":      aws.StringValue(place.Country),
+			"label":        aws.StringValue(place.Label),
+			"municipality": aws.StringValue(place.Municipality),
+			"postal_code":  aws.StringValue(place.PostalCode),
+			"region":       aws.StringValue(place.Region),
+		}
+
+		if place.Geometry != nil {
+			tfMap["geometry"] = []interface{}{
+				map[string]interface{}{
+					"point": flattenFloat64List(place.Geometry.Point),
+				},
+			}
+		}
+
+		if place.TimeZone != nil {
+			tfMap["time_zone"] = []interface{}{
+				map[string]interface{}{
+					"name":   aws.StringValue(place.TimeZone.Name),
+					"offset": aws.Int64Value(place.TimeZone.Offset),
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}